@@ -0,0 +1,127 @@
+package chi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BindError aggregates the field conversion failures encountered while
+// binding URL parameters or query values onto a struct.
+type BindError struct {
+	Errors []error
+}
+
+func (e *BindError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return "chi: bind: " + strings.Join(msgs, "; ")
+}
+
+func (e *BindError) add(err error) {
+	e.Errors = append(e.Errors, err)
+}
+
+// BindParams fills the fields of dst, which must be a pointer to a struct,
+// from the URL parameters of r. Fields are matched by a `chi:"name"` tag,
+// falling back to the field name. Pointer fields are left nil when the
+// parameter is absent. Conversion failures are collected and returned
+// together as a *BindError.
+func BindParams(r *http.Request, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("chi: BindParams: dst must be a pointer to a struct")
+	}
+
+	rctx := RouteContext(r.Context())
+	if rctx == nil {
+		return nil
+	}
+
+	v = v.Elem()
+	t := v.Type()
+
+	var bindErr BindError
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+
+		name := field.Tag.Get("chi")
+		if name == "" {
+			name = field.Name
+		}
+
+		raw, ok := rctx.URLParams.Lookup(name)
+		if !ok {
+			continue // leave pointer fields nil, others zero
+		}
+
+		if err := setFieldValue(v.Field(i), raw); err != nil {
+			bindErr.add(fmt.Errorf("field %q: %w", field.Name, err))
+		}
+	}
+
+	if len(bindErr.Errors) > 0 {
+		return &bindErr
+	}
+	return nil
+}
+
+// setFieldValue converts raw into v's type and sets it, allocating the
+// pointee of pointer fields as needed.
+func setFieldValue(v reflect.Value, raw string) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	if v.Type() == reflect.TypeOf(time.Time{}) {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package chi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func requestWithParams(pairs ...string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	rctx := NewRouteContext()
+	for i := 0; i+1 < len(pairs); i += 2 {
+		rctx.URLParams.Add(pairs[i], pairs[i+1])
+	}
+	return r.WithContext(WithRouteContext(r.Context(), rctx))
+}
+
+func TestBindParamsRequiresPointerToStruct(t *testing.T) {
+	r := requestWithParams("id", "1")
+
+	if err := BindParams(r, "not a struct"); err == nil {
+		t.Fatal("expected error for non-pointer dst")
+	}
+
+	var notAStruct int
+	if err := BindParams(r, &notAStruct); err == nil {
+		t.Fatal("expected error for pointer-to-non-struct dst")
+	}
+}
+
+func TestBindParamsRejectsBadDstWithoutRouteContext(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	if err := BindParams(r, "not a struct"); err == nil {
+		t.Fatal("expected dst validation error even without a routing context")
+	}
+}
+
+func TestBindParamsNoRouteContext(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	var dst struct {
+		ID int `chi:"id"`
+	}
+	if err := BindParams(r, &dst); err != nil {
+		t.Fatalf("BindParams without routing context: %v", err)
+	}
+	if dst.ID != 0 {
+		t.Fatalf("dst.ID = %d, want 0", dst.ID)
+	}
+}
+
+func TestBindParamsTagAndFallback(t *testing.T) {
+	r := requestWithParams("id", "42", "Name", "widget")
+
+	var dst struct {
+		ID   int `chi:"id"`
+		Name string
+	}
+	if err := BindParams(r, &dst); err != nil {
+		t.Fatalf("BindParams: %v", err)
+	}
+	if dst.ID != 42 {
+		t.Fatalf("dst.ID = %d, want 42", dst.ID)
+	}
+	if dst.Name != "widget" {
+		t.Fatalf("dst.Name = %q, want widget", dst.Name)
+	}
+}
+
+func TestBindParamsPointerFieldAbsent(t *testing.T) {
+	r := requestWithParams("id", "1")
+
+	var dst struct {
+		ID     int `chi:"id"`
+		Parent *int
+	}
+	if err := BindParams(r, &dst); err != nil {
+		t.Fatalf("BindParams: %v", err)
+	}
+	if dst.Parent != nil {
+		t.Fatalf("dst.Parent = %v, want nil", *dst.Parent)
+	}
+}
+
+func TestBindParamsTimeAndUnsupportedKind(t *testing.T) {
+	r := requestWithParams("at", "2024-01-02T15:04:05Z", "bad", "nope")
+
+	var dst struct {
+		At  time.Time `chi:"at"`
+		Bad complex64  `chi:"bad"`
+	}
+	err := BindParams(r, &dst)
+	if err == nil {
+		t.Fatal("expected BindError for unsupported field kind")
+	}
+	bindErr, ok := err.(*BindError)
+	if !ok {
+		t.Fatalf("err = %T, want *BindError", err)
+	}
+	if len(bindErr.Errors) != 1 {
+		t.Fatalf("len(bindErr.Errors) = %d, want 1", len(bindErr.Errors))
+	}
+	if !dst.At.Equal(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)) {
+		t.Fatalf("dst.At = %v", dst.At)
+	}
+}
+
+func TestBindParamsConversionFailuresAggregate(t *testing.T) {
+	r := requestWithParams("a", "not-an-int", "b", "not-a-bool")
+
+	var dst struct {
+		A int  `chi:"a"`
+		B bool `chi:"b"`
+	}
+	err := BindParams(r, &dst)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	bindErr, ok := err.(*BindError)
+	if !ok {
+		t.Fatalf("err = %T, want *BindError", err)
+	}
+	if len(bindErr.Errors) != 2 {
+		t.Fatalf("len(bindErr.Errors) = %d, want 2", len(bindErr.Errors))
+	}
+}
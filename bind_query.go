@@ -0,0 +1,102 @@
+package chi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// BindQuery fills the fields of dst, which must be a pointer to a struct,
+// from the Queries that AddQueries stored on r; a request that did not pass
+// through AddQueries binds as if it had no query values. Fields are matched
+// by a `query:"name"` tag,
+// falling back to the field name. The tag may carry a `,default=...` value
+// used when the query parameter is absent, and a `,required` option that
+// fails binding when it is. Slice fields are populated from the
+// comma-split values produced by extractQueries. Conversion failures are
+// collected and returned together as a *BindError.
+func BindQuery(r *http.Request, dst interface{}) error {
+	queries := GetQueries(r)
+
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("chi: BindQuery: dst must be a pointer to a struct")
+	}
+	v = v.Elem()
+	t := v.Type()
+
+	var bindErr BindError
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+
+		name, defaultValue, required := parseQueryTag(field)
+
+		values := queries.Get(name, nil)
+		if len(values) == 0 {
+			if required {
+				bindErr.add(fmt.Errorf("field %q: query parameter %q is required", field.Name, name))
+				continue
+			}
+			if defaultValue == "" {
+				continue
+			}
+			values = []string{defaultValue}
+		}
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Slice {
+			if err := setSliceFieldValue(fv, values); err != nil {
+				bindErr.add(fmt.Errorf("field %q: %w", field.Name, err))
+			}
+			continue
+		}
+
+		if err := setFieldValue(fv, values[0]); err != nil {
+			bindErr.add(fmt.Errorf("field %q: %w", field.Name, err))
+		}
+	}
+
+	if len(bindErr.Errors) > 0 {
+		return &bindErr
+	}
+	return nil
+}
+
+// parseQueryTag splits a `query:"name,default=...,required"` tag into its
+// parts.
+func parseQueryTag(field reflect.StructField) (name, defaultValue string, required bool) {
+	tag := field.Tag.Get("query")
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	for _, opt := range parts[1:] {
+		switch {
+		case opt == "required":
+			required = true
+		case strings.HasPrefix(opt, "default="):
+			defaultValue = strings.TrimPrefix(opt, "default=")
+		}
+	}
+	return name, defaultValue, required
+}
+
+// setSliceFieldValue converts raw into v's slice element type and sets it.
+func setSliceFieldValue(v reflect.Value, raw []string) error {
+	elemType := v.Type().Elem()
+	out := reflect.MakeSlice(v.Type(), len(raw), len(raw))
+	for i, s := range raw {
+		ev := reflect.New(elemType).Elem()
+		if err := setFieldValue(ev, s); err != nil {
+			return err
+		}
+		out.Index(i).Set(ev)
+	}
+	v.Set(out)
+	return nil
+}
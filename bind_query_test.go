@@ -0,0 +1,114 @@
+package chi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func requestWithQuery(rawQuery string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/?"+rawQuery, nil)
+	return AddQueries(r)
+}
+
+func TestBindQueryRequiresPointerToStruct(t *testing.T) {
+	r := requestWithQuery("a=1")
+
+	if err := BindQuery(r, "not a struct"); err == nil {
+		t.Fatal("expected error for non-pointer dst")
+	}
+}
+
+func TestBindQueryTagDefaultAndRequired(t *testing.T) {
+	r := requestWithQuery("name=widget")
+
+	var dst struct {
+		Name  string `query:"name"`
+		Limit int    `query:"limit,default=10"`
+	}
+	if err := BindQuery(r, &dst); err != nil {
+		t.Fatalf("BindQuery: %v", err)
+	}
+	if dst.Name != "widget" {
+		t.Fatalf("dst.Name = %q, want widget", dst.Name)
+	}
+	if dst.Limit != 10 {
+		t.Fatalf("dst.Limit = %d, want 10 (default)", dst.Limit)
+	}
+}
+
+func TestBindQueryRequiredMissing(t *testing.T) {
+	r := requestWithQuery("")
+
+	var dst struct {
+		Name string `query:"name,required"`
+	}
+	err := BindQuery(r, &dst)
+	if err == nil {
+		t.Fatal("expected error for missing required field")
+	}
+	bindErr, ok := err.(*BindError)
+	if !ok {
+		t.Fatalf("err = %T, want *BindError", err)
+	}
+	if len(bindErr.Errors) != 1 {
+		t.Fatalf("len(bindErr.Errors) = %d, want 1", len(bindErr.Errors))
+	}
+}
+
+func TestBindQueryRequiredWithDefaultPrefersValue(t *testing.T) {
+	r := requestWithQuery("limit=5")
+
+	var dst struct {
+		Limit int `query:"limit,default=10,required"`
+	}
+	if err := BindQuery(r, &dst); err != nil {
+		t.Fatalf("BindQuery: %v", err)
+	}
+	if dst.Limit != 5 {
+		t.Fatalf("dst.Limit = %d, want 5", dst.Limit)
+	}
+}
+
+func TestBindQuerySlice(t *testing.T) {
+	r := requestWithQuery("ids=1,2,3")
+
+	var dst struct {
+		IDs []int `query:"ids"`
+	}
+	if err := BindQuery(r, &dst); err != nil {
+		t.Fatalf("BindQuery: %v", err)
+	}
+	if len(dst.IDs) != 3 || dst.IDs[0] != 1 || dst.IDs[1] != 2 || dst.IDs[2] != 3 {
+		t.Fatalf("dst.IDs = %v, want [1 2 3]", dst.IDs)
+	}
+}
+
+func TestBindQuerySliceConversionFailure(t *testing.T) {
+	r := requestWithQuery("ids=1,not-an-int,3")
+
+	var dst struct {
+		IDs []int `query:"ids"`
+	}
+	err := BindQuery(r, &dst)
+	if err == nil {
+		t.Fatal("expected error for unconvertible slice element")
+	}
+	if _, ok := err.(*BindError); !ok {
+		t.Fatalf("err = %T, want *BindError", err)
+	}
+}
+
+func TestBindQueryNoQueries(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	var dst struct {
+		Name string `query:"name,default=anon"`
+	}
+	if err := BindQuery(r, &dst); err != nil {
+		t.Fatalf("BindQuery: %v", err)
+	}
+	if dst.Name != "anon" {
+		t.Fatalf("dst.Name = %q, want anon", dst.Name)
+	}
+}
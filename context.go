@@ -27,6 +27,11 @@ type Context struct {
 	// Routing patterns throughout the lifecycle of the request,
 	// across all connected routers.
 	RoutePatterns []string
+
+	// RouteMeta holds arbitrary metadata attached to the matched route. It
+	// is filled per request by middleware such as TagRoutes and cleared by
+	// reset; nothing fills it when a route is mounted.
+	RouteMeta map[string]interface{}
 }
 
 // NewRouteContext returns a new routing Context object.
@@ -36,16 +41,18 @@ func NewRouteContext() *Context {
 
 // reset a routing context to its initial state.
 func (x *Context) reset() {
-	x.URLParams = x.URLParams[:0]
+	x.URLParams.reset()
 	x.RoutePath = ""
 	x.RoutePattern = ""
 	x.RoutePatterns = x.RoutePatterns[:0]
+	x.RouteMeta = nil
 }
 
 // RouteContext returns chi's routing Context object from a
 // http.Request Context.
 func RouteContext(ctx context.Context) *Context {
-	return ctx.Value(RouteCtxKey).(*Context)
+	val, _ := ctx.Value(RouteCtxKey).(*Context)
+	return val
 }
 
 // URLParam returns the url parameter from a http.Request object.
@@ -68,44 +75,114 @@ type param struct {
 	Key, Value string
 }
 
-type params []param
+// paramsIndexThreshold is the slice length above which params builds a map
+// index instead of paying for a linear scan on every Get.
+const paramsIndexThreshold = 8
+
+// params holds the URL parameters matched for a request. Routes with few
+// parameters (the overwhelming common case) are served by a plain linear
+// scan over s; once a request accumulates more than paramsIndexThreshold
+// params, Add builds a map index so repeated reads (e.g. several
+// middlewares each calling URLParam) stop paying for the scan. The index
+// is only ever built or rebuilt from Add/Del, never from Lookup, so
+// concurrent reads of the same params value stay race-free.
+type params struct {
+	s     []param
+	index map[string]int
+}
 
 func (ps *params) Add(key string, value string) {
-	*ps = append(*ps, param{key, value})
+	ps.s = append(ps.s, param{key, value})
+	switch {
+	case ps.index != nil:
+		// Keep the first occurrence of a key, as the linear scan does.
+		if _, ok := ps.index[key]; !ok {
+			ps.index[key] = len(ps.s) - 1
+		}
+	case len(ps.s) > paramsIndexThreshold:
+		ps.buildIndex()
+	}
 }
 
-func (ps params) Get(key string) string {
-	for _, p := range ps {
+func (ps *params) Get(key string) string {
+	v, _ := ps.Lookup(key)
+	return v
+}
+
+// Lookup returns the url parameter value for key and whether it was present,
+// distinguishing an absent parameter from one set to the empty string.
+// Lookup only reads ps.index; the index itself is only ever built or
+// rebuilt from Add/Del, so concurrent Lookup calls on the same params
+// value are safe.
+func (ps *params) Lookup(key string) (string, bool) {
+	if ps.index != nil {
+		i, ok := ps.index[key]
+		if !ok {
+			return "", false
+		}
+		return ps.s[i].Value, true
+	}
+	for _, p := range ps.s {
 		if p.Key == key {
-			return p.Value
+			return p.Value, true
 		}
 	}
-	return ""
+	return "", false
 }
 
-func (ps *params) Set(key string, value string) {
-	idx := -1
-	for i, p := range *ps {
+func (ps *params) buildIndex() {
+	ps.index = make(map[string]int, len(ps.s))
+	for i, p := range ps.s {
+		if _, ok := ps.index[p.Key]; !ok {
+			ps.index[p.Key] = i
+		}
+	}
+}
+
+// indexOf returns the slice position of key without forcing an index build,
+// since Set/Del touch the slice directly regardless of index size.
+func (ps *params) indexOf(key string) int {
+	if ps.index != nil {
+		if i, ok := ps.index[key]; ok {
+			return i
+		}
+		return -1
+	}
+	for i, p := range ps.s {
 		if p.Key == key {
-			idx = i
-			break
+			return i
 		}
 	}
-	if idx < 0 {
-		(*ps).Add(key, value)
-	} else {
-		(*ps)[idx] = param{key, value}
+	return -1
+}
+
+func (ps *params) Set(key string, value string) {
+	if i := ps.indexOf(key); i >= 0 {
+		ps.s[i] = param{key, value}
+		return
 	}
+	ps.Add(key, value)
 }
 
 func (ps *params) Del(key string) string {
-	for i, p := range *ps {
-		if p.Key == key {
-			*ps = append((*ps)[:i], (*ps)[i+1:]...)
-			return p.Value
-		}
+	i := ps.indexOf(key)
+	if i < 0 {
+		return ""
 	}
-	return ""
+	v := ps.s[i].Value
+	ps.s = append(ps.s[:i], ps.s[i+1:]...)
+	// Indices past i have shifted; rebuild now rather than patching the
+	// map in place, since Del already mutates the slice.
+	if ps.index != nil {
+		ps.buildIndex()
+	}
+	return v
+}
+
+// reset clears params for reuse, keeping the backing slice's capacity.
+func (ps *params) reset() {
+	ps.s = ps.s[:0]
+	ps.index = nil
 }
 
 // ServerBaseContext wraps an http.Handler to set the request context to the
@@ -0,0 +1,154 @@
+package chi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestRouteContextWithoutMux(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	if rctx := RouteContext(r.Context()); rctx != nil {
+		t.Fatalf("RouteContext = %v, want nil", rctx)
+	}
+	if v := URLParam(r, "id"); v != "" {
+		t.Fatalf("URLParam = %q, want empty", v)
+	}
+	if v := URLParamFromCtx(r.Context(), "id"); v != "" {
+		t.Fatalf("URLParamFromCtx = %q, want empty", v)
+	}
+}
+
+func TestParamsGetSetDel(t *testing.T) {
+	var ps params
+	ps.Add("a", "1")
+	ps.Add("b", "2")
+
+	if v := ps.Get("a"); v != "1" {
+		t.Fatalf("Get(a) = %q, want 1", v)
+	}
+	if v, ok := ps.Lookup("missing"); ok || v != "" {
+		t.Fatalf("Lookup(missing) = (%q, %v), want (\"\", false)", v, ok)
+	}
+
+	ps.Set("a", "3")
+	if v := ps.Get("a"); v != "3" {
+		t.Fatalf("Get(a) after Set = %q, want 3", v)
+	}
+
+	if v := ps.Del("a"); v != "3" {
+		t.Fatalf("Del(a) = %q, want 3", v)
+	}
+	if _, ok := ps.Lookup("a"); ok {
+		t.Fatalf("Lookup(a) after Del should be absent")
+	}
+	if v := ps.Get("b"); v != "2" {
+		t.Fatalf("Get(b) after Del(a) = %q, want 2", v)
+	}
+}
+
+func TestParamsIndexedLookup(t *testing.T) {
+	var ps params
+	for i := 0; i < 16; i++ {
+		ps.Add(fmt.Sprintf("k%d", i), fmt.Sprintf("v%d", i))
+	}
+	if ps.index == nil {
+		t.Fatal("expected index to be built once past paramsIndexThreshold")
+	}
+	for i := 0; i < 16; i++ {
+		if v := ps.Get(fmt.Sprintf("k%d", i)); v != fmt.Sprintf("v%d", i) {
+			t.Fatalf("Get(k%d) = %q, want v%d", i, v, i)
+		}
+	}
+
+	ps.Del("k0")
+	if ps.index == nil {
+		t.Fatal("expected index to remain built after Del")
+	}
+	if v := ps.Get("k15"); v != "v15" {
+		t.Fatalf("Get(k15) after Del(k0) = %q, want v15", v)
+	}
+}
+
+func TestParamsLookupConcurrentReads(t *testing.T) {
+	var ps params
+	for i := 0; i < 20; i++ {
+		ps.Add(fmt.Sprintf("k%d", i), fmt.Sprintf("v%d", i))
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				ps.Get(fmt.Sprintf("k%d", i))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestContextClone(t *testing.T) {
+	rctx := NewRouteContext()
+	rctx.URLParams.Add("id", "42")
+	rctx.RoutePattern = "/widgets/{id}"
+	rctx.RoutePatterns = append(rctx.RoutePatterns, "/widgets/{id}")
+	rctx.RouteMeta = map[string]interface{}{"tag": "v1"}
+
+	clone := rctx.Clone()
+	rctx.reset()
+
+	if v := clone.URLParams.Get("id"); v != "42" {
+		t.Fatalf("clone URLParams.Get(id) = %q, want 42", v)
+	}
+	if clone.RoutePattern != "/widgets/{id}" {
+		t.Fatalf("clone RoutePattern = %q, want /widgets/{id}", clone.RoutePattern)
+	}
+	if len(clone.RoutePatterns) != 1 || clone.RoutePatterns[0] != "/widgets/{id}" {
+		t.Fatalf("clone RoutePatterns = %v", clone.RoutePatterns)
+	}
+	if clone.RouteMeta["tag"] != "v1" {
+		t.Fatalf("clone RouteMeta[tag] = %v, want v1", clone.RouteMeta["tag"])
+	}
+}
+
+func TestRouteContextPool(t *testing.T) {
+	pool := NewRouteContextPool(2)
+	rctx := pool.Get()
+	rctx.URLParams.Add("id", "7")
+
+	ctx := WithRouteContext(context.Background(), rctx)
+	if v := URLParamFromCtx(ctx, "id"); v != "7" {
+		t.Fatalf("URLParamFromCtx = %q, want 7", v)
+	}
+
+	pool.Put(rctx)
+	rctx2 := pool.Get()
+	if v := rctx2.URLParams.Get("id"); v != "" {
+		t.Fatalf("reused Context not reset: URLParams.Get(id) = %q", v)
+	}
+}
+
+func benchmarkParamsGet(b *testing.B, n int) {
+	var ps params
+	for i := 0; i < n; i++ {
+		ps.Add(fmt.Sprintf("k%d", i), fmt.Sprintf("v%d", i))
+	}
+	key := fmt.Sprintf("k%d", n-1)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ps.Get(key)
+	}
+}
+
+func BenchmarkParamsGet1(b *testing.B)  { benchmarkParamsGet(b, 1) }
+func BenchmarkParamsGet3(b *testing.B)  { benchmarkParamsGet(b, 3) }
+func BenchmarkParamsGet8(b *testing.B)  { benchmarkParamsGet(b, 8) }
+func BenchmarkParamsGet16(b *testing.B) { benchmarkParamsGet(b, 16) }
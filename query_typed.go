@@ -0,0 +1,109 @@
+package chi
+
+import (
+	"strconv"
+	"time"
+)
+
+// First returns the first value for key, or defaultValue if key is absent
+// or empty.
+func (q Queries) First(key, defaultValue string) string {
+	values := q.Get(key, nil)
+	if len(values) == 0 || values[0] == "" {
+		return defaultValue
+	}
+	return values[0]
+}
+
+// GetInt returns the query value for key as an int, or def if key is absent
+// or cannot be converted.
+func (q Queries) GetInt(key string, def int) int {
+	v := q.First(key, "")
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// GetInt64 returns the query value for key as an int64, or def if key is
+// absent or cannot be converted.
+func (q Queries) GetInt64(key string, def int64) int64 {
+	v := q.First(key, "")
+	if v == "" {
+		return def
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// GetBool returns the query value for key as a bool, or def if key is
+// absent or cannot be converted.
+func (q Queries) GetBool(key string, def bool) bool {
+	v := q.First(key, "")
+	if v == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return def
+	}
+	return b
+}
+
+// GetFloat returns the query value for key as a float64, or def if key is
+// absent or cannot be converted.
+func (q Queries) GetFloat(key string, def float64) float64 {
+	v := q.First(key, "")
+	if v == "" {
+		return def
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return def
+	}
+	return f
+}
+
+// GetTime returns the query value for key parsed with layout, or def if key
+// is absent or cannot be converted.
+func (q Queries) GetTime(key, layout string, def time.Time) time.Time {
+	v := q.First(key, "")
+	if v == "" {
+		return def
+	}
+	t, err := time.Parse(layout, v)
+	if err != nil {
+		return def
+	}
+	return t
+}
+
+// GetStringSlice returns the query values for key, or def if key is absent.
+func (q Queries) GetStringSlice(key string, def []string) []string {
+	return q.Get(key, def)
+}
+
+// GetIntSlice returns the query values for key converted to ints, or def if
+// key is absent or any value cannot be converted.
+func (q Queries) GetIntSlice(key string, def []int) []int {
+	values := q.Get(key, nil)
+	if len(values) == 0 {
+		return def
+	}
+	out := make([]int, 0, len(values))
+	for _, v := range values {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return def
+		}
+		out = append(out, n)
+	}
+	return out
+}
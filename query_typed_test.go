@@ -0,0 +1,122 @@
+package chi
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQueriesFirst(t *testing.T) {
+	q := Queries{"name": {"widget"}}
+	if v := q.First("name", "def"); v != "widget" {
+		t.Fatalf("First = %q, want widget", v)
+	}
+	if v := q.First("missing", "def"); v != "def" {
+		t.Fatalf("First(missing) = %q, want def", v)
+	}
+	empty := Queries{"name": {""}}
+	if v := empty.First("name", "def"); v != "def" {
+		t.Fatalf("First(empty) = %q, want def", v)
+	}
+}
+
+func TestQueriesGetInt(t *testing.T) {
+	q := Queries{"n": {"42"}}
+	if v := q.GetInt("n", -1); v != 42 {
+		t.Fatalf("GetInt = %d, want 42", v)
+	}
+	if v := q.GetInt("missing", -1); v != -1 {
+		t.Fatalf("GetInt(missing) = %d, want -1", v)
+	}
+	bad := Queries{"n": {"nope"}}
+	if v := bad.GetInt("n", -1); v != -1 {
+		t.Fatalf("GetInt(bad) = %d, want -1 (default)", v)
+	}
+}
+
+func TestQueriesGetInt64(t *testing.T) {
+	q := Queries{"n": {"9223372036854775807"}}
+	if v := q.GetInt64("n", -1); v != 9223372036854775807 {
+		t.Fatalf("GetInt64 = %d, want max int64", v)
+	}
+	if v := q.GetInt64("missing", -1); v != -1 {
+		t.Fatalf("GetInt64(missing) = %d, want -1", v)
+	}
+	bad := Queries{"n": {"nope"}}
+	if v := bad.GetInt64("n", -1); v != -1 {
+		t.Fatalf("GetInt64(bad) = %d, want -1 (default)", v)
+	}
+}
+
+func TestQueriesGetBool(t *testing.T) {
+	q := Queries{"active": {"true"}}
+	if v := q.GetBool("active", false); v != true {
+		t.Fatalf("GetBool = %v, want true", v)
+	}
+	if v := q.GetBool("missing", true); v != true {
+		t.Fatalf("GetBool(missing) = %v, want true (default)", v)
+	}
+	bad := Queries{"active": {"nope"}}
+	if v := bad.GetBool("active", true); v != true {
+		t.Fatalf("GetBool(bad) = %v, want true (default)", v)
+	}
+}
+
+func TestQueriesGetFloat(t *testing.T) {
+	q := Queries{"f": {"3.14"}}
+	if v := q.GetFloat("f", -1); v != 3.14 {
+		t.Fatalf("GetFloat = %v, want 3.14", v)
+	}
+	if v := q.GetFloat("missing", -1); v != -1 {
+		t.Fatalf("GetFloat(missing) = %v, want -1", v)
+	}
+	bad := Queries{"f": {"nope"}}
+	if v := bad.GetFloat("f", -1); v != -1 {
+		t.Fatalf("GetFloat(bad) = %v, want -1 (default)", v)
+	}
+}
+
+func TestQueriesGetTime(t *testing.T) {
+	def := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	q := Queries{"at": {"2024-01-02T15:04:05Z"}}
+	tm := q.GetTime("at", time.RFC3339, def)
+	if !tm.Equal(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)) {
+		t.Fatalf("GetTime = %v", tm)
+	}
+	if tm := q.GetTime("missing", time.RFC3339, def); !tm.Equal(def) {
+		t.Fatalf("GetTime(missing) = %v, want %v (default)", tm, def)
+	}
+	bad := Queries{"at": {"not-a-time"}}
+	if tm := bad.GetTime("at", time.RFC3339, def); !tm.Equal(def) {
+		t.Fatalf("GetTime(layout mismatch) = %v, want %v (default)", tm, def)
+	}
+}
+
+func TestQueriesGetStringSlice(t *testing.T) {
+	q := Queries{"tags": {"a", "b"}}
+	got := q.GetStringSlice("tags", nil)
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Fatalf("GetStringSlice = %v, want [a b]", got)
+	}
+	def := []string{"x"}
+	if got := q.GetStringSlice("missing", def); len(got) != 1 || got[0] != "x" {
+		t.Fatalf("GetStringSlice(missing) = %v, want %v", got, def)
+	}
+}
+
+func TestQueriesGetIntSlice(t *testing.T) {
+	q := Queries{"ids": {"1", "2", "3"}}
+	got := q.GetIntSlice("ids", nil)
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("GetIntSlice = %v, want [1 2 3]", got)
+	}
+
+	def := []int{-1}
+	if got := q.GetIntSlice("missing", def); len(got) != 1 || got[0] != -1 {
+		t.Fatalf("GetIntSlice(missing) = %v, want %v", got, def)
+	}
+
+	bad := Queries{"ids": {"1", "nope", "3"}}
+	if got := bad.GetIntSlice("ids", def); len(got) != 1 || got[0] != -1 {
+		t.Fatalf("GetIntSlice(bad element) = %v, want %v (default)", got, def)
+	}
+}
@@ -0,0 +1,67 @@
+package chi
+
+import (
+	"context"
+	"sync"
+)
+
+// RouteContextPool is a pool of *Context values. It exposes the same
+// zero-alloc reuse the mux keeps internally, for callers that drive their
+// own request loop but still want chi's *Context and URL-param machinery.
+type RouteContextPool struct {
+	pool sync.Pool
+}
+
+// NewRouteContextPool returns a RouteContextPool, pre-warmed with size
+// Context values.
+func NewRouteContextPool(size int) *RouteContextPool {
+	p := &RouteContextPool{
+		pool: sync.Pool{
+			New: func() interface{} {
+				return NewRouteContext()
+			},
+		},
+	}
+	for i := 0; i < size; i++ {
+		p.pool.Put(NewRouteContext())
+	}
+	return p
+}
+
+// Get returns a reset *Context ready for a new request.
+func (p *RouteContextPool) Get() *Context {
+	rctx := p.pool.Get().(*Context)
+	rctx.reset()
+	return rctx
+}
+
+// Put returns rctx to the pool for reuse.
+func (p *RouteContextPool) Put(rctx *Context) {
+	p.pool.Put(rctx)
+}
+
+// WithRouteContext returns a copy of ctx carrying rctx as chi's routing
+// context.
+func WithRouteContext(ctx context.Context, rctx *Context) context.Context {
+	return context.WithValue(ctx, RouteCtxKey, rctx)
+}
+
+// Clone returns a deep copy of x, safe to stash in a goroutine or async
+// pipeline. A Context obtained from the mux's pool is unsafe to retain
+// past the request because reset() recycles its backing slice; Clone
+// detaches from that lifecycle entirely.
+func (x *Context) Clone() *Context {
+	clone := &Context{
+		RoutePath:    x.RoutePath,
+		RoutePattern: x.RoutePattern,
+	}
+	clone.URLParams.s = append([]param(nil), x.URLParams.s...)
+	clone.RoutePatterns = append([]string(nil), x.RoutePatterns...)
+	if x.RouteMeta != nil {
+		clone.RouteMeta = make(map[string]interface{}, len(x.RouteMeta))
+		for k, v := range x.RouteMeta {
+			clone.RouteMeta[k] = v
+		}
+	}
+	return clone
+}
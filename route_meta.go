@@ -0,0 +1,73 @@
+package chi
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+// RouteMeta returns the metadata map attached to the matched route for the
+// current request, or nil if the route has none.
+func RouteMeta(ctx context.Context) map[string]interface{} {
+	rctx := RouteContext(ctx)
+	if rctx == nil {
+		return nil
+	}
+	return rctx.RouteMeta
+}
+
+// RouteMetaValue returns the metadata value for key on the matched route for
+// the current request, or nil if the route has no metadata or key is absent.
+func RouteMetaValue(ctx context.Context, key string) interface{} {
+	meta := RouteMeta(ctx)
+	if meta == nil {
+		return nil
+	}
+	return meta[key]
+}
+
+// RequireHost returns a middleware that only serves requests whose r.Host
+// (ignoring any port) matches one of hosts, responding 404 for any other
+// host.
+func RequireHost(hosts ...string) func(http.Handler) http.Handler {
+	allowed := make(map[string]struct{}, len(hosts))
+	for _, h := range hosts {
+		allowed[h] = struct{}{}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			host := r.Host
+			if h, _, err := net.SplitHostPort(host); err == nil {
+				host = h
+			} else if len(host) > 1 && host[0] == '[' && host[len(host)-1] == ']' {
+				// A bracketed IPv6 literal with no port, e.g. "[::1]",
+				// isn't split by SplitHostPort since there's no colon
+				// after the closing bracket.
+				host = host[1 : len(host)-1]
+			}
+			if _, ok := allowed[host]; !ok {
+				http.NotFound(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// TagRoutes returns a middleware that records tag under the "tag" key of the
+// matched route's metadata, so it can be discovered at runtime via
+// RouteMetaValue(ctx, "tag") instead of inspecting RoutePattern by hand.
+func TagRoutes(tag string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if rctx := RouteContext(r.Context()); rctx != nil {
+				if rctx.RouteMeta == nil {
+					rctx.RouteMeta = map[string]interface{}{}
+				}
+				rctx.RouteMeta["tag"] = tag
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
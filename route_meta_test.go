@@ -0,0 +1,96 @@
+package chi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRouteMetaNilBeforeMatch(t *testing.T) {
+	ctx := httptest.NewRequest("GET", "/", nil).Context()
+	if meta := RouteMeta(ctx); meta != nil {
+		t.Fatalf("RouteMeta = %v, want nil", meta)
+	}
+	if v := RouteMetaValue(ctx, "tag"); v != nil {
+		t.Fatalf("RouteMetaValue = %v, want nil", v)
+	}
+}
+
+func TestTagRoutesRouteMetaValueRoundTrip(t *testing.T) {
+	rctx := NewRouteContext()
+	r := httptest.NewRequest("GET", "/", nil)
+	r = r.WithContext(WithRouteContext(r.Context(), rctx))
+
+	var got interface{}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = RouteMetaValue(r.Context(), "tag")
+	})
+
+	TagRoutes("admin")(next).ServeHTTP(httptest.NewRecorder(), r)
+
+	if got != "admin" {
+		t.Fatalf("RouteMetaValue(tag) = %v, want admin", got)
+	}
+}
+
+func okHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func TestRequireHostPlainHost(t *testing.T) {
+	h := RequireHost("admin.example.com")(okHandler())
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Host = "admin.example.com"
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+
+	r2 := httptest.NewRequest("GET", "/", nil)
+	r2.Host = "other.example.com"
+	w2 := httptest.NewRecorder()
+	h.ServeHTTP(w2, r2)
+	if w2.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404", w2.Code)
+	}
+}
+
+func TestRequireHostWithPort(t *testing.T) {
+	h := RequireHost("admin.example.com")(okHandler())
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Host = "admin.example.com:8080"
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+}
+
+func TestRequireHostIPv6WithPort(t *testing.T) {
+	h := RequireHost("::1")(okHandler())
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Host = "[::1]:8080"
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+}
+
+func TestRequireHostIPv6NoPort(t *testing.T) {
+	h := RequireHost("::1")(okHandler())
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Host = "[::1]"
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+}
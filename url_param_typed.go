@@ -0,0 +1,144 @@
+package chi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrURLParamNotFound indicates the requested URL parameter is not present
+// in the current routing context.
+var ErrURLParamNotFound = errors.New("chi: url parameter not found")
+
+// urlParamValue returns the raw url parameter value for key, or
+// ErrURLParamNotFound if there is no routing context or the parameter is
+// absent.
+func urlParamValue(r *http.Request, key string) (string, error) {
+	rctx := RouteContext(r.Context())
+	if rctx == nil {
+		return "", ErrURLParamNotFound
+	}
+	v, ok := rctx.URLParams.Lookup(key)
+	if !ok {
+		return "", ErrURLParamNotFound
+	}
+	return v, nil
+}
+
+// URLParamInt returns the url parameter from a http.Request object as an int.
+func URLParamInt(r *http.Request, key string) (int, error) {
+	v, err := urlParamValue(r, key)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("chi: url parameter %q: %w", key, err)
+	}
+	return n, nil
+}
+
+// URLParamInt64 returns the url parameter from a http.Request object as an
+// int64.
+func URLParamInt64(r *http.Request, key string) (int64, error) {
+	v, err := urlParamValue(r, key)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("chi: url parameter %q: %w", key, err)
+	}
+	return n, nil
+}
+
+// URLParamUint returns the url parameter from a http.Request object as a
+// uint.
+func URLParamUint(r *http.Request, key string) (uint, error) {
+	v, err := urlParamValue(r, key)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("chi: url parameter %q: %w", key, err)
+	}
+	return uint(n), nil
+}
+
+// URLParamBool returns the url parameter from a http.Request object as a
+// bool.
+func URLParamBool(r *http.Request, key string) (bool, error) {
+	v, err := urlParamValue(r, key)
+	if err != nil {
+		return false, err
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("chi: url parameter %q: %w", key, err)
+	}
+	return b, nil
+}
+
+// URLParamUUID returns the url parameter from a http.Request object as a
+// canonical, lowercased UUID string (8-4-4-4-12 hex digits).
+func URLParamUUID(r *http.Request, key string) (string, error) {
+	v, err := urlParamValue(r, key)
+	if err != nil {
+		return "", err
+	}
+	if !isValidUUID(v) {
+		return "", fmt.Errorf("chi: url parameter %q: invalid uuid %q", key, v)
+	}
+	return strings.ToLower(v), nil
+}
+
+// URLParamTime returns the url parameter from a http.Request object parsed
+// with the given time layout.
+func URLParamTime(r *http.Request, key, layout string) (time.Time, error) {
+	v, err := urlParamValue(r, key)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(layout, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("chi: url parameter %q: %w", key, err)
+	}
+	return t, nil
+}
+
+// MustURLParamInt returns the url parameter from a http.Request object as an
+// int, panicking if the parameter is missing or cannot be converted.
+func MustURLParamInt(r *http.Request, key string) int {
+	n, err := URLParamInt(r, key)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func isValidUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isHexDigit(s[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isHexDigit(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
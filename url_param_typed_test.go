@@ -0,0 +1,148 @@
+package chi
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestURLParamIntFound(t *testing.T) {
+	r := requestWithParams("id", "42")
+	n, err := URLParamInt(r, "id")
+	if err != nil {
+		t.Fatalf("URLParamInt: %v", err)
+	}
+	if n != 42 {
+		t.Fatalf("URLParamInt = %d, want 42", n)
+	}
+}
+
+func TestURLParamIntAbsent(t *testing.T) {
+	r := requestWithParams("id", "42")
+	if _, err := URLParamInt(r, "missing"); !errors.Is(err, ErrURLParamNotFound) {
+		t.Fatalf("URLParamInt(missing) err = %v, want ErrURLParamNotFound", err)
+	}
+}
+
+func TestURLParamIntBadValue(t *testing.T) {
+	r := requestWithParams("id", "nope")
+	if _, err := URLParamInt(r, "id"); err == nil || errors.Is(err, ErrURLParamNotFound) {
+		t.Fatalf("URLParamInt(bad) err = %v, want wrapped parse error", err)
+	}
+}
+
+func TestURLParamInt64(t *testing.T) {
+	r := requestWithParams("id", "9223372036854775807")
+	n, err := URLParamInt64(r, "id")
+	if err != nil {
+		t.Fatalf("URLParamInt64: %v", err)
+	}
+	if n != 9223372036854775807 {
+		t.Fatalf("URLParamInt64 = %d, want max int64", n)
+	}
+
+	if _, err := URLParamInt64(r, "missing"); !errors.Is(err, ErrURLParamNotFound) {
+		t.Fatalf("URLParamInt64(missing) err = %v, want ErrURLParamNotFound", err)
+	}
+
+	bad := requestWithParams("id", "nope")
+	if _, err := URLParamInt64(bad, "id"); err == nil {
+		t.Fatal("URLParamInt64(bad) expected error")
+	}
+}
+
+func TestURLParamUint(t *testing.T) {
+	r := requestWithParams("id", "7")
+	n, err := URLParamUint(r, "id")
+	if err != nil {
+		t.Fatalf("URLParamUint: %v", err)
+	}
+	if n != 7 {
+		t.Fatalf("URLParamUint = %d, want 7", n)
+	}
+
+	if _, err := URLParamUint(r, "missing"); !errors.Is(err, ErrURLParamNotFound) {
+		t.Fatalf("URLParamUint(missing) err = %v, want ErrURLParamNotFound", err)
+	}
+
+	bad := requestWithParams("id", "-1")
+	if _, err := URLParamUint(bad, "id"); err == nil {
+		t.Fatal("URLParamUint(bad) expected error")
+	}
+}
+
+func TestURLParamBool(t *testing.T) {
+	r := requestWithParams("active", "true")
+	b, err := URLParamBool(r, "active")
+	if err != nil {
+		t.Fatalf("URLParamBool: %v", err)
+	}
+	if !b {
+		t.Fatal("URLParamBool = false, want true")
+	}
+
+	if _, err := URLParamBool(r, "missing"); !errors.Is(err, ErrURLParamNotFound) {
+		t.Fatalf("URLParamBool(missing) err = %v, want ErrURLParamNotFound", err)
+	}
+
+	bad := requestWithParams("active", "nope")
+	if _, err := URLParamBool(bad, "active"); err == nil {
+		t.Fatal("URLParamBool(bad) expected error")
+	}
+}
+
+func TestURLParamUUID(t *testing.T) {
+	r := requestWithParams("id", "550E8400-E29B-41D4-A716-446655440000")
+	v, err := URLParamUUID(r, "id")
+	if err != nil {
+		t.Fatalf("URLParamUUID: %v", err)
+	}
+	if v != "550e8400-e29b-41d4-a716-446655440000" {
+		t.Fatalf("URLParamUUID = %q, want lowercased uuid", v)
+	}
+
+	if _, err := URLParamUUID(r, "missing"); !errors.Is(err, ErrURLParamNotFound) {
+		t.Fatalf("URLParamUUID(missing) err = %v, want ErrURLParamNotFound", err)
+	}
+
+	bad := requestWithParams("id", "not-a-uuid")
+	if _, err := URLParamUUID(bad, "id"); err == nil {
+		t.Fatal("URLParamUUID(bad) expected error")
+	}
+}
+
+func TestURLParamTime(t *testing.T) {
+	r := requestWithParams("at", "2024-01-02T15:04:05Z")
+	tm, err := URLParamTime(r, "at", time.RFC3339)
+	if err != nil {
+		t.Fatalf("URLParamTime: %v", err)
+	}
+	if !tm.Equal(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)) {
+		t.Fatalf("URLParamTime = %v", tm)
+	}
+
+	if _, err := URLParamTime(r, "missing", time.RFC3339); !errors.Is(err, ErrURLParamNotFound) {
+		t.Fatalf("URLParamTime(missing) err = %v, want ErrURLParamNotFound", err)
+	}
+
+	bad := requestWithParams("at", "not-a-time")
+	if _, err := URLParamTime(bad, "at", time.RFC3339); err == nil {
+		t.Fatal("URLParamTime(bad) expected error")
+	}
+}
+
+func TestMustURLParamInt(t *testing.T) {
+	r := requestWithParams("id", "5")
+	if n := MustURLParamInt(r, "id"); n != 5 {
+		t.Fatalf("MustURLParamInt = %d, want 5", n)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("MustURLParamInt(missing) expected panic")
+		}
+	}()
+	r2 := httptest.NewRequest("GET", "/", nil)
+	MustURLParamInt(r2, "missing")
+}